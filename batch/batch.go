@@ -0,0 +1,107 @@
+// Package batch implements atomic multi-transaction submission: several
+// signed transactions plus one aggregated submitter signature, submitted to
+// the sequencer as a single all-or-nothing `submitBatch` call.
+package batch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/zkLinkProtocol/zklink_sdk/client"
+	sig "github.com/zkLinkProtocol/zklink_sdk/signer"
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+)
+
+// entry is one signed transaction accumulated into a Batch: its already
+// zklink_sdk-serialized wire form, the tx hash that form was produced from,
+// and its optional L1 eth signature (transfers/withdraws have none,
+// ChangePubKey does).
+type entry struct {
+	zklinkTx     sdk.ZklinkTx
+	txHash       []byte
+	ethSignature *sdk.PackedEthSignature
+}
+
+// Batch accumulates signed transactions and produces a single submitBatch
+// RPC payload signed once by the submitter, so e.g. a ChangePubKey and the
+// Transfer that depends on it settle together or not at all.
+type Batch struct {
+	entries   []entry
+	signature sdk.ZkLinkSignature
+}
+
+// NewBatchBuilder returns an empty Batch ready to accumulate transactions.
+func NewBatchBuilder() *Batch {
+	return &Batch{}
+}
+
+// Add appends a signed transaction to the batch: zklinkTx is its
+// already-serialized wire form (e.g. sdk.ZklinkTxFromChangePubkey's result),
+// txHash is the same tx's TxHash() used to sign it, and ethSignature may be
+// nil for transaction types that don't carry an L1 signature.
+func (b *Batch) Add(zklinkTx sdk.ZklinkTx, txHash []byte, ethSignature *sdk.PackedEthSignature) {
+	b.entries = append(b.entries, entry{zklinkTx: zklinkTx, txHash: txHash, ethSignature: ethSignature})
+}
+
+// Hash concatenates every transaction's hash, in insertion order, the value
+// the batch's aggregated submitter signature is computed over.
+func (b *Batch) Hash() []byte {
+	var buf bytes.Buffer
+	for _, e := range b.entries {
+		buf.Write(e.txHash)
+	}
+	return buf.Bytes()
+}
+
+// Sign computes the batch's aggregated submitter signature by calling
+// submitter.SignMusig over Hash(). It must be called after every tx has been
+// Added, and before Submit.
+func (b *Batch) Sign(submitter sig.Signer) error {
+	if len(b.entries) == 0 {
+		return fmt.Errorf("batch: cannot sign an empty batch")
+	}
+	signature, err := submitter.SignMusig(b.Hash())
+	if err != nil {
+		return err
+	}
+	b.signature = signature
+	return nil
+}
+
+type submitBatchParams struct {
+	Txs                 []json.RawMessage `json:"txs"`
+	EthSignatures       []json.RawMessage `json:"ethSignatures"`
+	SubmitterSignature  sdk.ZkLinkSignature `json:"submitterSignature"`
+}
+
+// Submit sends the batch to c as a single submitBatch RPC call. Sign must
+// have been called first.
+func (b *Batch) Submit(c *client.Client) (json.RawMessage, error) {
+	if b.signature == "" {
+		return nil, fmt.Errorf("batch: Sign must be called before Submit")
+	}
+	txs := make([]json.RawMessage, len(b.entries))
+	ethSignatures := make([]json.RawMessage, len(b.entries))
+	for i, e := range b.entries {
+		txs[i] = json.RawMessage(e.zklinkTx)
+		if e.ethSignature != nil {
+			data, err := json.Marshal(e.ethSignature)
+			if err != nil {
+				return nil, err
+			}
+			ethSignatures[i] = data
+		} else {
+			ethSignatures[i] = json.RawMessage("null")
+		}
+	}
+	payload, err := json.Marshal(submitBatchParams{
+		Txs:                txs,
+		EthSignatures:      ethSignatures,
+		SubmitterSignature: b.signature,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.SubmitBatch(payload)
+}
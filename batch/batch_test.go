@@ -0,0 +1,44 @@
+package batch
+
+import (
+	"testing"
+
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSigner struct{ signed []byte }
+
+func (f *fakeSigner) Address() (sdk.ZkLinkAddress, error)            { return "", nil }
+func (f *fakeSigner) PublicKey() (sdk.PackedPublicKey, error)         { return "", nil }
+func (f *fakeSigner) SignEthMessage(msg []byte) (sdk.PackedEthSignature, error) {
+	return "", nil
+}
+func (f *fakeSigner) SignMusig(msg []byte) (sdk.ZkLinkSignature, error) {
+	f.signed = msg
+	return sdk.ZkLinkSignature("0xsigned"), nil
+}
+
+func TestSignRejectsEmptyBatch(t *testing.T) {
+	b := NewBatchBuilder()
+	err := b.Sign(&fakeSigner{})
+	assert.Error(t, err)
+}
+
+func TestHashConcatenatesTxHashesInOrder(t *testing.T) {
+	b := NewBatchBuilder()
+	b.Add(sdk.ZklinkTx(`{"type":"Transfer"}`), []byte{1, 2}, nil)
+	b.Add(sdk.ZklinkTx(`{"type":"Withdraw"}`), []byte{3, 4}, nil)
+	assert.Equal(t, []byte{1, 2, 3, 4}, b.Hash())
+}
+
+func TestSignSetsSubmitterSignatureOverHash(t *testing.T) {
+	b := NewBatchBuilder()
+	b.Add(sdk.ZklinkTx(`{"type":"Transfer"}`), []byte{1, 2}, nil)
+
+	signer := &fakeSigner{}
+	err := b.Sign(signer)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 2}, signer.signed)
+	assert.Equal(t, sdk.ZkLinkSignature("0xsigned"), b.signature)
+}
@@ -0,0 +1,77 @@
+package binding_tests
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zkLinkProtocol/zklink_sdk/eip712"
+	// The rest of this package tests the uniffi bindings generated under
+	// binding_tests/generated/uniffi/zklink_sdk, but eip712's exported
+	// functions are typed against go_example's copy of the generated sdk
+	// package (see eip712/eip712.go), so golden-vector tests for it must
+	// import that copy too rather than the binding_tests one.
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+)
+
+// goldenDomain is the fixed EIP-712 domain every golden vector below is
+// hashed under: zkLink v1 on chain 1, verifying contract the zero address.
+func goldenDomain() eip712.Domain {
+	return eip712.NewDomain(1, sdk.ZkLinkAddress("0x0000000000000000000000000000000000000000"))
+}
+
+func assertGoldenHash(t *testing.T, want string, hash []byte, err error) {
+	t.Helper()
+	assert.NoError(t, err)
+	assert.Equal(t, want, hex.EncodeToString(hash))
+}
+
+// These expected hashes were computed independently from the EIP-712 spec
+// (domain separator + ABI-encoded hashStruct per atomic encoding rule) against
+// the exact field values below, not captured from this package's own output,
+// so a regression in encodeType/encodeValue/hashStruct that still produces a
+// deterministic-but-wrong hash will be caught.
+func TestEip712HashOfChangePubKeyGoldenVector(t *testing.T) {
+	tx := sdk.ChangePubKey{
+		AccountId:    2,
+		SubAccountId: 4,
+		NewPkHash:    sdk.PubKeyHash("0xd8d5fb6a6caef06aa3dc2abdcdc240987e5330fe"),
+		FeeToken:     1,
+		Fee:          *big.NewInt(100),
+		Nonce:        100,
+	}
+	_, hash, err := eip712.Eip712HashOfChangePubKey(tx, goldenDomain())
+	assertGoldenHash(t, "55a2a5c3d99c5acf4262248ff461ade1656adfc33f5ac2b82548b850b7213880", hash, err)
+}
+
+func TestEip712HashOfTransferGoldenVector(t *testing.T) {
+	tx := sdk.Transfer{
+		AccountId:        10,
+		ToAddress:        sdk.ZkLinkAddress("0xafaff3ad1a0425d792432d9ecd1c3e26ef2c42e9"),
+		FromSubAccountId: 1,
+		ToSubAccountId:   1,
+		Token:            18,
+		Amount:           sdk.BigUint("10000"),
+		Fee:              sdk.BigUint("3"),
+		Nonce:            1,
+	}
+	_, hash, err := eip712.Eip712HashOfTransfer(tx, goldenDomain())
+	assertGoldenHash(t, "79bbe7618c2a5598d1ce40ead849aef44227c4a965d6dfecdd78cf2703de8537", hash, err)
+}
+
+func TestEip712HashOfWithdrawGoldenVector(t *testing.T) {
+	tx := sdk.Withdraw{
+		AccountId:     10,
+		SubAccountId:  1,
+		ToChainId:     1,
+		ToAddress:     sdk.ZkLinkAddress("0xafaff3ad1a0425d792432d9ecd1c3e26ef2c42e9"),
+		L2SourceToken: 18,
+		L1TargetToken: 2,
+		Amount:        sdk.BigUint("5000"),
+		Fee:           sdk.BigUint("3"),
+		Nonce:         7,
+	}
+	_, hash, err := eip712.Eip712HashOfWithdraw(tx, goldenDomain())
+	assertGoldenHash(t, "b820e193a87c89cc63941180f431520ce545f0059b2cf7088d417b6214e1d41b", hash, err)
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CallBatch is CallBatchContext with context.Background(), for callers that
+// don't need cancellation.
+func (c *Client) CallBatch(calls []BatchCall) ([]json.RawMessage, error) {
+	return c.CallBatchContext(context.Background(), calls)
+}
+
+// CallBatchContext sends several JSON-RPC calls in a single HTTP round trip,
+// e.g. a caller submitting an array of signed transactions at once. Unlike
+// CallContext it does not fan out across endpoints or retry; it posts once
+// to the first configured endpoint. Responses are matched back to calls by
+// their JSON-RPC id, since the spec does not guarantee a batch response
+// preserves request order.
+func (c *Client) CallBatchContext(ctx context.Context, calls []BatchCall) ([]json.RawMessage, error) {
+	if len(calls) == 0 {
+		return nil, nil
+	}
+	reqs := make([]rpcRequest, len(calls))
+	for i, call := range calls {
+		reqs[i] = rpcRequest{Id: int64(i + 1), JsonRpc: "2.0", Method: call.Method, Params: call.Params}
+	}
+	body, err := json.Marshal(reqs)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.post(ctx, c.cfg.Endpoints[0], body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var resps []rpcResponse
+	if err := json.NewDecoder(resp.Body).Decode(&resps); err != nil {
+		return nil, err
+	}
+	byId := make(map[int64]rpcResponse, len(resps))
+	for _, r := range resps {
+		byId[r.Id] = r
+	}
+	results := make([]json.RawMessage, len(calls))
+	for i, req := range reqs {
+		r, ok := byId[req.Id]
+		if !ok {
+			return nil, fmt.Errorf("client: batch response missing result for request id %d", req.Id)
+		}
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		results[i] = r.Result
+	}
+	return results, nil
+}
+
+// BatchCall is one call within a CallBatch request.
+type BatchCall struct {
+	Method string
+	Params []json.RawMessage
+}
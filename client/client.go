@@ -0,0 +1,209 @@
+// Package client provides a typed, multi-endpoint JSON-RPC client for the
+// zklink_sdk examples, replacing the one-off http.Post calls with retries,
+// sharder-style consensus across endpoints, batching and subscriptions.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Config configures a Client. Endpoints are dialed in parallel on every
+// call and the response is accepted once at least MinConfirmation of them
+// agree, the same consensus rule zkLink's sharders use among themselves.
+type Config struct {
+	Endpoints       []string
+	MinConfirmation int
+	MaxTxnQuery     int
+	QuerySleepTime  time.Duration
+	HTTPClient      *http.Client
+}
+
+// Client is a JSON-RPC client that fans a call out to every configured
+// endpoint and only returns once enough of them agree.
+type Client struct {
+	cfg Config
+}
+
+// NewClient builds a Client from cfg, filling in defaults for unset fields:
+// MinConfirmation 1 (no consensus), MaxTxnQuery 5 attempts per endpoint,
+// QuerySleepTime 500ms and http.DefaultClient.
+func NewClient(cfg Config) (*Client, error) {
+	if len(cfg.Endpoints) == 0 {
+		return nil, fmt.Errorf("client: at least one endpoint is required")
+	}
+	if cfg.MinConfirmation <= 0 {
+		cfg.MinConfirmation = 1
+	}
+	if cfg.MinConfirmation > len(cfg.Endpoints) {
+		return nil, fmt.Errorf("client: MinConfirmation %d exceeds %d endpoints", cfg.MinConfirmation, len(cfg.Endpoints))
+	}
+	if cfg.MaxTxnQuery <= 0 {
+		cfg.MaxTxnQuery = 5
+	}
+	if cfg.QuerySleepTime <= 0 {
+		cfg.QuerySleepTime = 500 * time.Millisecond
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+	return &Client{cfg: cfg}, nil
+}
+
+type rpcRequest struct {
+	Id      int64             `json:"id"`
+	JsonRpc string            `json:"jsonrpc"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+}
+
+type rpcResponse struct {
+	Id     int64           `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string { return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message) }
+
+// Call is CallContext with context.Background(), for callers that don't need
+// cancellation.
+func (c *Client) Call(method string, params ...json.RawMessage) (json.RawMessage, error) {
+	return c.CallContext(context.Background(), method, params...)
+}
+
+// CallContext issues method with params against every configured endpoint
+// with exponential-backoff retries and returns the result once at least
+// MinConfirmation endpoints return byte-identical results. ctx is threaded
+// into every underlying HTTP request, so canceling it (or its deadline
+// expiring) aborts every in-flight endpoint call.
+func (c *Client) CallContext(ctx context.Context, method string, params ...json.RawMessage) (json.RawMessage, error) {
+	req := rpcRequest{Id: 1, JsonRpc: "2.0", Method: method, Params: params}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	type result struct {
+		data json.RawMessage
+		err  error
+	}
+	results := make(chan result, len(c.cfg.Endpoints))
+	for _, endpoint := range c.cfg.Endpoints {
+		endpoint := endpoint
+		go func() {
+			data, err := c.postWithRetry(ctx, endpoint, body)
+			results <- result{data: data, err: err}
+		}()
+	}
+
+	counts := make(map[string]int)
+	var lastErr error
+	for i := 0; i < len(c.cfg.Endpoints); i++ {
+		r := <-results
+		if r.err != nil {
+			lastErr = r.err
+			continue
+		}
+		key := string(r.data)
+		counts[key]++
+		if counts[key] >= c.cfg.MinConfirmation {
+			return r.data, nil
+		}
+	}
+	if lastErr != nil {
+		return nil, fmt.Errorf("client: %d endpoints did not reach consensus, last error: %w", len(c.cfg.Endpoints), lastErr)
+	}
+	return nil, fmt.Errorf("client: %d endpoints did not reach consensus", len(c.cfg.Endpoints))
+}
+
+func (c *Client) postWithRetry(ctx context.Context, endpoint string, body []byte) (json.RawMessage, error) {
+	var lastErr error
+	sleep := c.cfg.QuerySleepTime
+	for attempt := 0; attempt < c.cfg.MaxTxnQuery; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(sleep):
+			}
+			sleep *= 2
+		}
+		resp, err := c.post(ctx, endpoint, body)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		var parsed rpcResponse
+		err = json.NewDecoder(resp.Body).Decode(&parsed)
+		resp.Body.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if parsed.Error != nil {
+			lastErr = parsed.Error
+			continue
+		}
+		return parsed.Result, nil
+	}
+	return nil, lastErr
+}
+
+func (c *Client) post(ctx context.Context, endpoint string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.cfg.HTTPClient.Do(req)
+}
+
+// SendTransaction submits a signed transaction with its submitter signature,
+// mirroring the `sendTransaction` RPC the examples call today.
+func (c *Client) SendTransaction(tx json.RawMessage, ethSignature json.RawMessage, submitterSignature json.RawMessage) (json.RawMessage, error) {
+	params := []json.RawMessage{tx}
+	if ethSignature != nil {
+		params = append(params, ethSignature)
+	} else {
+		params = append(params, json.RawMessage("null"))
+	}
+	params = append(params, submitterSignature)
+	return c.Call("sendTransaction", params...)
+}
+
+// GetAccountState fetches the current state of an account.
+func (c *Client) GetAccountState(accountIdOrAddress string) (json.RawMessage, error) {
+	param, err := json.Marshal(accountIdOrAddress)
+	if err != nil {
+		return nil, err
+	}
+	return c.Call("getAccountState", param)
+}
+
+// EstimateFee estimates the fee for a transaction type on a given chain.
+func (c *Client) EstimateFee(chainId uint32, txType string) (json.RawMessage, error) {
+	chainParam, err := json.Marshal(chainId)
+	if err != nil {
+		return nil, err
+	}
+	typeParam, err := json.Marshal(txType)
+	if err != nil {
+		return nil, err
+	}
+	return c.Call("estimateFee", chainParam, typeParam)
+}
+
+// SubmitBatch submits a pre-built `submitBatch` RPC payload, as produced by
+// the batch package's Batch.Submit.
+func (c *Client) SubmitBatch(batchPayload json.RawMessage) (json.RawMessage, error) {
+	return c.Call("submitBatch", batchPayload)
+}
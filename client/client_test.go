@@ -0,0 +1,41 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fakeServer(t *testing.T, result string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"result":` + result + `,"error":null}`))
+	}))
+}
+
+func TestClientCallReachesConsensus(t *testing.T) {
+	srv := fakeServer(t, `"0x1"`)
+	defer srv.Close()
+
+	c, err := NewClient(Config{Endpoints: []string{srv.URL, srv.URL}, MinConfirmation: 2})
+	assert.NoError(t, err)
+
+	result, err := c.Call("getAccountState")
+	assert.NoError(t, err)
+	var decoded string
+	assert.NoError(t, json.Unmarshal(result, &decoded))
+	assert.Equal(t, "0x1", decoded)
+}
+
+func TestNewClientRejectsMinConfirmationAboveEndpointCount(t *testing.T) {
+	_, err := NewClient(Config{Endpoints: []string{"http://a"}, MinConfirmation: 2})
+	assert.Error(t, err)
+}
+
+func TestNewClientRejectsNoEndpoints(t *testing.T) {
+	_, err := NewClient(Config{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gorilla/websocket"
+)
+
+// TxStatusUpdate is one message delivered on a txStatus subscription.
+type TxStatusUpdate struct {
+	TxHash string          `json:"txHash"`
+	Status string          `json:"status"`
+	Detail json.RawMessage `json:"detail"`
+}
+
+// Subscription is a live `txStatus` stream opened on one of the client's
+// endpoints. Call Close when done to release the underlying connection.
+type Subscription struct {
+	conn *websocket.Conn
+	ch   chan TxStatusUpdate
+	errc chan error
+}
+
+// SubscribeTxStatus opens a websocket subscription to transaction status
+// updates for txHash on the first configured endpoint. The endpoint's
+// scheme is rewritten from http(s) to ws(s) automatically.
+func (c *Client) SubscribeTxStatus(txHash string) (*Subscription, error) {
+	wsURL, err := toWebsocketURL(c.cfg.Endpoints[0])
+	if err != nil {
+		return nil, err
+	}
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("client: dial websocket: %w", err)
+	}
+	subscribeReq := rpcRequest{
+		Id:      1,
+		JsonRpc: "2.0",
+		Method:  "subscribeTxStatus",
+		Params:  []json.RawMessage{mustMarshal(txHash)},
+	}
+	if err := conn.WriteJSON(subscribeReq); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	sub := &Subscription{conn: conn, ch: make(chan TxStatusUpdate, 16), errc: make(chan error, 1)}
+	go sub.readLoop()
+	return sub, nil
+}
+
+func (s *Subscription) readLoop() {
+	defer close(s.ch)
+	for {
+		var update TxStatusUpdate
+		if err := s.conn.ReadJSON(&update); err != nil {
+			s.errc <- err
+			return
+		}
+		s.ch <- update
+	}
+}
+
+// Updates returns the channel txStatus updates are delivered on. It is
+// closed when the subscription ends; check Err afterwards for the cause.
+func (s *Subscription) Updates() <-chan TxStatusUpdate { return s.ch }
+
+// Err returns the error that ended the subscription, if any.
+func (s *Subscription) Err() error {
+	select {
+	case err := <-s.errc:
+		return err
+	default:
+		return nil
+	}
+}
+
+// Close terminates the subscription and its underlying connection.
+func (s *Subscription) Close() error {
+	return s.conn.Close()
+}
+
+func toWebsocketURL(endpoint string) (string, error) {
+	switch {
+	case len(endpoint) >= 5 && endpoint[:5] == "https":
+		return "wss" + endpoint[5:], nil
+	case len(endpoint) >= 4 && endpoint[:4] == "http":
+		return "ws" + endpoint[4:], nil
+	default:
+		return "", fmt.Errorf("client: unsupported endpoint scheme in %q", endpoint)
+	}
+}
+
+func mustMarshal(v interface{}) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+
+	sig "github.com/zkLinkProtocol/zklink_sdk/signer"
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+)
+
+// BuildChangePubkeyRequestWithEthEcdsaAuthData wraps
+// sdk.BuildChangePubkeyRequestWithEthEcdsaAuthData so callers pass a
+// signer.Signer instead of a raw hex private key, and main_contract /
+// l1_client_id are filled in from the global Config when the caller passes
+// their zero values.
+//
+// sdk.BuildChangePubkeyRequestWithEthEcdsaAuthData itself still only accepts
+// a hex private key, so this only supports Signer values backed by one
+// (signer.PrivateKeySigner); other backends (remote/TSS) return an error
+// until zklink_sdk's own builder accepts something more general than a hex
+// string.
+func BuildChangePubkeyRequestWithEthEcdsaAuthData(
+	signer sig.Signer,
+	builder sdk.ChangePubKeyBuilder,
+	l1ClientId uint32,
+	mainContract sdk.ZkLinkAddress,
+) (string, error) {
+	pks, ok := signer.(*sig.PrivateKeySigner)
+	if !ok {
+		return "", fmt.Errorf("config: BuildChangePubkeyRequestWithEthEcdsaAuthData requires a *signer.PrivateKeySigner until zklink_sdk's builder accepts other Signer backends")
+	}
+
+	cfg := GetConfig()
+	if l1ClientId == 0 {
+		l1ClientId = cfg.L1ClientId
+	}
+	if mainContract == "" {
+		resolved, err := cfg.MainContractFor(builder.ChainId)
+		if err != nil {
+			return "", err
+		}
+		mainContract = resolved
+	}
+	return sdk.BuildChangePubkeyRequestWithEthEcdsaAuthData(pks.Hex(), builder, l1ClientId, mainContract)
+}
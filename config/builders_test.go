@@ -0,0 +1,37 @@
+package config
+
+import (
+	"testing"
+
+	sig "github.com/zkLinkProtocol/zklink_sdk/signer"
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubSigner struct{}
+
+var _ sig.Signer = stubSigner{}
+
+func (stubSigner) Address() (sdk.ZkLinkAddress, error)                 { return "", nil }
+func (stubSigner) PublicKey() (sdk.PackedPublicKey, error)             { return "", nil }
+func (stubSigner) SignEthMessage(msg []byte) (sdk.PackedEthSignature, error) { return "", nil }
+func (stubSigner) SignMusig(msg []byte) (sdk.ZkLinkSignature, error)   { return "", nil }
+
+func TestBuildChangePubkeyRequestWithEthEcdsaAuthDataRejectsNonPrivateKeySigners(t *testing.T) {
+	err := Init(Config{
+		Network:    Testnet,
+		L1ClientId: 1,
+		MainContract: map[sdk.ChainId]sdk.ZkLinkAddress{
+			sdk.ChainId(1): sdk.ZkLinkAddress("0x0000000000000000000000000000000000000000"),
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = BuildChangePubkeyRequestWithEthEcdsaAuthData(
+		stubSigner{},
+		sdk.ChangePubKeyBuilder{ChainId: sdk.ChainId(1)},
+		0,
+		"",
+	)
+	assert.Error(t, err)
+}
@@ -0,0 +1,126 @@
+// Package config holds process-wide zklink_sdk configuration, so examples
+// stop re-declaring the network URL, chain id and main contract address as
+// local variables in every function.
+package config
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+)
+
+// Network selects which preconfigured zkLink network a Config targets.
+type Network string
+
+const (
+	Mainnet Network = "mainnet"
+	Testnet Network = "testnet"
+	Custom  Network = "custom"
+)
+
+// Config is the process-wide zklink_sdk configuration installed by Init.
+type Config struct {
+	Network Network
+
+	// MainContract maps a ChainId to the main contract address deployed on
+	// that chain, e.g. ChangePubKey's EIP-712/EIP-191 domain verifier.
+	MainContract map[sdk.ChainId]sdk.ZkLinkAddress
+
+	// L1ClientId is the default l1_client_id builders fall back to when the
+	// caller passes zero.
+	L1ClientId uint32
+
+	// Endpoints is the default RPC endpoint list for GetConfig()
+	// consumers that build a client.Client.
+	Endpoints []string
+
+	// DefaultFeeToken is the token id used when a builder isn't given an
+	// explicit fee token.
+	DefaultFeeToken sdk.TokenId
+
+	Logger *log.Logger
+}
+
+var (
+	mu      sync.RWMutex
+	current *Config
+)
+
+// Init installs cfg as the process-wide configuration. It must be called
+// once before any code relies on GetConfig.
+func Init(cfg Config) error {
+	if cfg.Network == "" {
+		return fmt.Errorf("config: Network must be set")
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = log.Default()
+	}
+	switch cfg.Network {
+	case Mainnet:
+		if len(cfg.Endpoints) == 0 {
+			cfg.Endpoints = []string{sdk.ZklinkMainNetUrl()}
+		}
+	case Testnet:
+		if len(cfg.Endpoints) == 0 {
+			cfg.Endpoints = []string{sdk.ZklinkTestNetUrl()}
+		}
+	case Custom:
+		if len(cfg.Endpoints) == 0 {
+			return fmt.Errorf("config: Custom network requires at least one Endpoint")
+		}
+	default:
+		return fmt.Errorf("config: unknown network %q", cfg.Network)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	current = &cfg
+	return nil
+}
+
+// GetConfig returns the process-wide configuration installed by Init. It
+// panics if Init has not been called, the same way using an unconfigured
+// SDK would be a programmer error.
+func GetConfig() *Config {
+	mu.RLock()
+	defer mu.RUnlock()
+	if current == nil {
+		panic("config: zklink_sdk.Init must be called before GetConfig")
+	}
+	return current
+}
+
+// MainContractFor returns the configured main contract address for chainId,
+// or an error if the chain hasn't been configured.
+func (c *Config) MainContractFor(chainId sdk.ChainId) (sdk.ZkLinkAddress, error) {
+	addr, ok := c.MainContract[chainId]
+	if !ok {
+		return "", fmt.Errorf("config: no MainContract configured for chain %v", chainId)
+	}
+	return addr, nil
+}
+
+// ctxKey is an unexported type so WithContext's values don't collide with
+// other packages' context keys.
+type ctxKey struct{}
+
+// WithContext attaches cfg to ctx, so code further down the call chain can
+// recover it with FromContext instead of relying on the global singleton.
+// It does not by itself propagate ctx's cancellation anywhere; callers that
+// want that should pass ctx on to client.Client's *Context methods
+// (e.g. CallContext, CallBatchContext) directly.
+func WithContext(ctx context.Context, cfg *Config) context.Context {
+	return context.WithValue(ctx, ctxKey{}, cfg)
+}
+
+// FromContext returns the Config attached by WithContext, falling back to
+// the global singleton installed by Init if ctx carries none.
+func FromContext(ctx context.Context) *Config {
+	if cfg, ok := ctx.Value(ctxKey{}).(*Config); ok {
+		return cfg
+	}
+	return GetConfig()
+}
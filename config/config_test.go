@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitRejectsCustomNetworkWithoutEndpoints(t *testing.T) {
+	err := Init(Config{Network: Custom})
+	assert.Error(t, err)
+}
+
+func TestInitAndGetConfigRoundTrip(t *testing.T) {
+	err := Init(Config{
+		Network:    Testnet,
+		L1ClientId: 1,
+		MainContract: map[sdk.ChainId]sdk.ZkLinkAddress{
+			sdk.ChainId(1): sdk.ZkLinkAddress("0x0000000000000000000000000000000000000000"),
+		},
+	})
+	assert.NoError(t, err)
+
+	cfg := GetConfig()
+	assert.Equal(t, uint32(1), cfg.L1ClientId)
+
+	addr, err := cfg.MainContractFor(sdk.ChainId(1))
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.ZkLinkAddress("0x0000000000000000000000000000000000000000"), addr)
+}
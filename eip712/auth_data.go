@@ -0,0 +1,42 @@
+package eip712
+
+import (
+	sig "github.com/zkLinkProtocol/zklink_sdk/signer"
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+)
+
+// AuthDataEip712 carries an EIP-712 signature over a ChangePubKey, together
+// with the TypedData it was taken over so a verifier can re-derive the same
+// hash.
+//
+// It deliberately does NOT implement sdk.ChangePubKeyAuthData: that type is
+// the closed 3-variant uniffi enum generated from the Rust core
+// (ChangePubKeyAuthDataOnChain / ChangePubKeyAuthDataEthCreate2 /
+// ChangePubKeyAuthDataEthEcdsa), and uniffi-bindgen-go's sealed-interface
+// pattern means only variant structs defined inside the generated sdk
+// package can satisfy it — a struct defined here never will, and the Rust
+// core behind the FFI was never built to recognize a 4th variant either.
+// Until zklink_sdk's own Rust/uniffi definitions grow a real EIP-712
+// variant, this is returned as its own honestly-typed value instead.
+type AuthDataEip712 struct {
+	EthSignature sdk.PackedEthSignature
+	TypedData    TypedData
+}
+
+// BuildChangePubkeyRequestWithEip712AuthData signs tx's EIP-712 hash (under
+// the domain for l1ClientId/mainContract) with signer and returns the
+// resulting AuthDataEip712. See AuthDataEip712 for why its result cannot be
+// used anywhere an sdk.ChangePubKeyAuthData is expected today.
+func BuildChangePubkeyRequestWithEip712AuthData(
+	signer sig.Signer,
+	tx sdk.ChangePubKey,
+	l1ClientId uint32,
+	mainContract sdk.ZkLinkAddress,
+) (AuthDataEip712, error) {
+	domain := NewDomain(l1ClientId, mainContract)
+	ethSignature, typedData, err := SignEip712ChangePubKey(signer, tx, domain)
+	if err != nil {
+		return AuthDataEip712{}, err
+	}
+	return AuthDataEip712{EthSignature: ethSignature, TypedData: typedData}, nil
+}
@@ -0,0 +1,37 @@
+package eip712
+
+import (
+	"testing"
+
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func testDomain() Domain {
+	return NewDomain(1, sdk.ZkLinkAddress("0x0000000000000000000000000000000000000000"))
+}
+
+func TestEip712HashOfChangePubKeyIsDeterministic(t *testing.T) {
+	tx := sdk.ChangePubKey{
+		AccountId:    2,
+		SubAccountId: 4,
+		NewPkHash:    sdk.PubKeyHash("0xd8d5fb6a6caef06aa3dc2abdcdc240987e5330fe"),
+		FeeToken:     1,
+		Nonce:        100,
+	}
+	_, hashA, err := Eip712HashOfChangePubKey(tx, testDomain())
+	assert.NoError(t, err)
+	_, hashB, err := Eip712HashOfChangePubKey(tx, testDomain())
+	assert.NoError(t, err)
+	assert.Equal(t, hashA, hashB)
+	assert.Len(t, hashA, 32)
+}
+
+func TestEip712HashDiffersByDomain(t *testing.T) {
+	tx := sdk.ChangePubKey{AccountId: 2, SubAccountId: 4, FeeToken: 1, Nonce: 100}
+	_, hashMainnet, err := Eip712HashOfChangePubKey(tx, NewDomain(1, sdk.ZkLinkAddress("0x01")))
+	assert.NoError(t, err)
+	_, hashTestnet, err := Eip712HashOfChangePubKey(tx, NewDomain(2, sdk.ZkLinkAddress("0x01")))
+	assert.NoError(t, err)
+	assert.NotEqual(t, hashMainnet, hashTestnet)
+}
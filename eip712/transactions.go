@@ -0,0 +1,214 @@
+package eip712
+
+import (
+	sig "github.com/zkLinkProtocol/zklink_sdk/signer"
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+)
+
+// Eip712HashOfDeposit builds the typed-data payload and hash for a Deposit.
+func Eip712HashOfDeposit(tx sdk.Deposit, domain Domain) (TypedData, []byte, error) {
+	td := TypedData{
+		Domain:      domain,
+		PrimaryType: "Deposit",
+		Types: map[string][]TypedField{
+			"EIP712Domain": domainTypes,
+			"Deposit": {
+				{"fromChainId", "uint8"},
+				{"subAccountId", "uint8"},
+				{"l2TargetToken", "uint32"},
+				{"l1SourceToken", "uint32"},
+				{"amount", "uint128"},
+				{"serialId", "uint64"},
+			},
+		},
+		Message: map[string]interface{}{
+			"fromChainId":   tx.FromChainId,
+			"subAccountId":  tx.SubAccountId,
+			"l2TargetToken": tx.L2TargetToken,
+			"l1SourceToken": tx.L1SourceToken,
+			"amount":        tx.Amount,
+			"serialId":      tx.SerialId,
+		},
+	}
+	hash, err := hashTypedData(td)
+	return td, hash, err
+}
+
+// SignEip712Deposit hashes tx under domain and signs it with signer,
+// returning a signature in the same hex-packed format EIP-191 signing uses.
+func SignEip712Deposit(signer sig.Signer, tx sdk.Deposit, domain Domain) (sdk.PackedEthSignature, TypedData, error) {
+	td, hash, err := Eip712HashOfDeposit(tx, domain)
+	if err != nil {
+		return "", td, err
+	}
+	signature, err := signer.SignEthMessage(hash)
+	return signature, td, err
+}
+
+// Eip712HashOfTransfer builds the typed-data payload and hash for a Transfer.
+func Eip712HashOfTransfer(tx sdk.Transfer, domain Domain) (TypedData, []byte, error) {
+	td := TypedData{
+		Domain:      domain,
+		PrimaryType: "Transfer",
+		Types: map[string][]TypedField{
+			"EIP712Domain": domainTypes,
+			"Transfer": {
+				{"accountId", "uint32"},
+				{"toAddress", "address"},
+				{"fromSubAccountId", "uint8"},
+				{"toSubAccountId", "uint8"},
+				{"token", "uint32"},
+				{"amount", "uint128"},
+				{"fee", "uint128"},
+				{"nonce", "uint32"},
+			},
+		},
+		Message: map[string]interface{}{
+			"accountId":        tx.AccountId,
+			"toAddress":        tx.ToAddress,
+			"fromSubAccountId": tx.FromSubAccountId,
+			"toSubAccountId":   tx.ToSubAccountId,
+			"token":            tx.Token,
+			"amount":           tx.Amount,
+			"fee":              tx.Fee,
+			"nonce":            tx.Nonce,
+		},
+	}
+	hash, err := hashTypedData(td)
+	return td, hash, err
+}
+
+// SignEip712Transfer hashes tx under domain and signs it with signer,
+// returning a signature in the same hex-packed format EIP-191 signing uses.
+func SignEip712Transfer(signer sig.Signer, tx sdk.Transfer, domain Domain) (sdk.PackedEthSignature, TypedData, error) {
+	td, hash, err := Eip712HashOfTransfer(tx, domain)
+	if err != nil {
+		return "", td, err
+	}
+	signature, err := signer.SignEthMessage(hash)
+	return signature, td, err
+}
+
+// Eip712HashOfWithdraw builds the typed-data payload and hash for a Withdraw.
+func Eip712HashOfWithdraw(tx sdk.Withdraw, domain Domain) (TypedData, []byte, error) {
+	td := TypedData{
+		Domain:      domain,
+		PrimaryType: "Withdraw",
+		Types: map[string][]TypedField{
+			"EIP712Domain": domainTypes,
+			"Withdraw": {
+				{"accountId", "uint32"},
+				{"subAccountId", "uint8"},
+				{"toChainId", "uint8"},
+				{"toAddress", "address"},
+				{"l2SourceToken", "uint32"},
+				{"l1TargetToken", "uint32"},
+				{"amount", "uint128"},
+				{"fee", "uint128"},
+				{"nonce", "uint32"},
+			},
+		},
+		Message: map[string]interface{}{
+			"accountId":     tx.AccountId,
+			"subAccountId":  tx.SubAccountId,
+			"toChainId":     tx.ToChainId,
+			"toAddress":     tx.ToAddress,
+			"l2SourceToken": tx.L2SourceToken,
+			"l1TargetToken": tx.L1TargetToken,
+			"amount":        tx.Amount,
+			"fee":           tx.Fee,
+			"nonce":         tx.Nonce,
+		},
+	}
+	hash, err := hashTypedData(td)
+	return td, hash, err
+}
+
+// SignEip712Withdraw hashes tx under domain and signs it with signer,
+// returning a signature in the same hex-packed format EIP-191 signing uses.
+func SignEip712Withdraw(signer sig.Signer, tx sdk.Withdraw, domain Domain) (sdk.PackedEthSignature, TypedData, error) {
+	td, hash, err := Eip712HashOfWithdraw(tx, domain)
+	if err != nil {
+		return "", td, err
+	}
+	signature, err := signer.SignEthMessage(hash)
+	return signature, td, err
+}
+
+// Eip712HashOfForcedExit builds the typed-data payload and hash for a ForcedExit.
+func Eip712HashOfForcedExit(tx sdk.ForcedExit, domain Domain) (TypedData, []byte, error) {
+	td := TypedData{
+		Domain:      domain,
+		PrimaryType: "ForcedExit",
+		Types: map[string][]TypedField{
+			"EIP712Domain": domainTypes,
+			"ForcedExit": {
+				{"initiatorAccountId", "uint32"},
+				{"initiatorSubAccountId", "uint8"},
+				{"targetAccountId", "uint32"},
+				{"toChainId", "uint8"},
+				{"l2SourceToken", "uint32"},
+				{"l1TargetToken", "uint32"},
+				{"nonce", "uint32"},
+			},
+		},
+		Message: map[string]interface{}{
+			"initiatorAccountId":    tx.InitiatorAccountId,
+			"initiatorSubAccountId": tx.InitiatorSubAccountId,
+			"targetAccountId":       tx.TargetAccountId,
+			"toChainId":             tx.ToChainId,
+			"l2SourceToken":         tx.L2SourceToken,
+			"l1TargetToken":         tx.L1TargetToken,
+			"nonce":                 tx.Nonce,
+		},
+	}
+	hash, err := hashTypedData(td)
+	return td, hash, err
+}
+
+// SignEip712ForcedExit hashes tx under domain and signs it with signer,
+// returning a signature in the same hex-packed format EIP-191 signing uses.
+func SignEip712ForcedExit(signer sig.Signer, tx sdk.ForcedExit, domain Domain) (sdk.PackedEthSignature, TypedData, error) {
+	td, hash, err := Eip712HashOfForcedExit(tx, domain)
+	if err != nil {
+		return "", td, err
+	}
+	signature, err := signer.SignEthMessage(hash)
+	return signature, td, err
+}
+
+// Eip712HashOfOrderMatching builds the typed-data payload and hash for an OrderMatching.
+func Eip712HashOfOrderMatching(tx sdk.OrderMatching, domain Domain) (TypedData, []byte, error) {
+	td := TypedData{
+		Domain:      domain,
+		PrimaryType: "OrderMatching",
+		Types: map[string][]TypedField{
+			"EIP712Domain": domainTypes,
+			"OrderMatching": {
+				{"accountId", "uint32"},
+				{"subAccountId", "uint8"},
+				{"fee", "uint128"},
+				{"feeToken", "uint32"},
+			},
+		},
+		Message: map[string]interface{}{
+			"accountId":    tx.AccountId,
+			"subAccountId": tx.SubAccountId,
+			"fee":          tx.Fee,
+			"feeToken":     tx.FeeToken,
+		},
+	}
+	hash, err := hashTypedData(td)
+	return td, hash, err
+}
+
+// SignEip712OrderMatching hashes tx under domain and signs it with signer,
+// returning a signature in the same hex-packed format EIP-191 signing uses.
+func SignEip712OrderMatching(signer sig.Signer, tx sdk.OrderMatching, domain Domain) (sdk.PackedEthSignature, TypedData, error) {
+	td, hash, err := Eip712HashOfOrderMatching(tx, domain)
+	if err != nil {
+		return "", td, err
+	}
+	signature, err := signer.SignEthMessage(hash)
+	return signature, td, err
+}
@@ -0,0 +1,49 @@
+package ens
+
+import (
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+)
+
+// NewTransferByName is NewTransfer with the recipient given as an ENS name
+// instead of a raw ZkLinkAddress, e.g. addressToName == "alice.eth".
+func NewTransferByName(
+	resolver *Resolver,
+	accountId sdk.AccountId,
+	addressToName string,
+	fromSubAccountId sdk.SubAccountId,
+	toSubAccountId sdk.SubAccountId,
+	token sdk.TokenId,
+	amount sdk.BigUint,
+	fee sdk.BigUint,
+	nonce sdk.Nonce,
+	ethSignature *sdk.PackedEthSignature,
+	ts sdk.TimeStamp,
+) (sdk.Transfer, error) {
+	addressTo, err := resolver.Resolve(addressToName)
+	if err != nil {
+		return sdk.Transfer{}, err
+	}
+	return sdk.NewTransfer(
+		accountId,
+		addressTo,
+		fromSubAccountId,
+		toSubAccountId,
+		token,
+		amount,
+		fee,
+		nonce,
+		ethSignature,
+		ts,
+	), nil
+}
+
+// ResolveAndBuild resolves name to a ZkLinkAddress and passes it to build,
+// the pattern the high-level builders use instead of taking a name directly,
+// e.g. a closure wrapping sdk.NewDeposit.
+func ResolveAndBuild(resolver *Resolver, name string, build func(sdk.ZkLinkAddress) (interface{}, error)) (interface{}, error) {
+	address, err := resolver.Resolve(name)
+	if err != nil {
+		return nil, err
+	}
+	return build(address)
+}
@@ -0,0 +1,170 @@
+// Package ens resolves human-readable ENS names to ZkLinkAddress values (and
+// back), so callers can write "alice.eth" instead of a raw hex address when
+// building transactions.
+package ens
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+	"golang.org/x/crypto/sha3"
+)
+
+// defaultTTL is how long a resolved name/address pair is cached for.
+const defaultTTL = 5 * time.Minute
+
+// Resolver resolves ENS names against an L1 ENS registry over JSON-RPC.
+type Resolver struct {
+	l1RpcUrl string
+	registry string
+	ttl      time.Duration
+	client   *http.Client
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	address   sdk.ZkLinkAddress
+	name      string
+	expiresAt time.Time
+}
+
+// NewEnsResolver returns a Resolver backed by the ENS registry at
+// registryAddress on the L1 network reachable at l1RpcUrl.
+func NewEnsResolver(l1RpcUrl string, registryAddress sdk.ZkLinkAddress) *Resolver {
+	return &Resolver{
+		l1RpcUrl: l1RpcUrl,
+		registry: string(registryAddress),
+		ttl:      defaultTTL,
+		client:   http.DefaultClient,
+		cache:    make(map[string]cacheEntry),
+	}
+}
+
+// Resolve looks up the ZkLinkAddress registered for an ENS name, e.g.
+// "alice.eth". It performs namehash(name) -> resolver(node) -> addr(node)
+// against the L1 registry and caches the result for the resolver's TTL.
+func (r *Resolver) Resolve(name string) (sdk.ZkLinkAddress, error) {
+	r.mu.Lock()
+	if entry, ok := r.cache[name]; ok && time.Now().Before(entry.expiresAt) {
+		r.mu.Unlock()
+		return entry.address, nil
+	}
+	r.mu.Unlock()
+
+	node := namehash(name)
+	resolverWord, err := r.ethCall(r.registry, "0x0178b8bf"+node) // resolver(bytes32)
+	if err != nil {
+		return "", fmt.Errorf("ens: resolve resolver for %q: %w", name, err)
+	}
+	resolverAddr := "0x" + strings.TrimPrefix(resolverWord, "0x")[24:]
+	addrHex, err := r.ethCall(resolverAddr, "0x3b3b57de"+node) // addr(bytes32)
+	if err != nil {
+		return "", fmt.Errorf("ens: resolve address for %q: %w", name, err)
+	}
+	address := sdk.ZkLinkAddress("0x" + strings.TrimPrefix(addrHex, "0x")[24:])
+
+	r.mu.Lock()
+	r.cache[name] = cacheEntry{address: address, name: name, expiresAt: time.Now().Add(r.ttl)}
+	r.mu.Unlock()
+	return address, nil
+}
+
+// Name reverse-resolves an address to its primary ENS name via the
+// "addr.reverse" namespace, for display purposes. It returns an empty string
+// if the address has no registered reverse record.
+func (r *Resolver) Name(address sdk.ZkLinkAddress) (string, error) {
+	reverseNode := namehash(strings.TrimPrefix(string(address), "0x") + ".addr.reverse")
+	resolverWord, err := r.ethCall(r.registry, "0x0178b8bf"+reverseNode)
+	if err != nil {
+		return "", fmt.Errorf("ens: resolve reverse resolver for %s: %w", address, err)
+	}
+	resolverAddr := "0x" + strings.TrimPrefix(resolverWord, "0x")[24:]
+	nameHex, err := r.ethCall(resolverAddr, "0x691f3431"+reverseNode) // name(bytes32)
+	if err != nil {
+		return "", fmt.Errorf("ens: resolve name for %s: %w", address, err)
+	}
+	return decodeAbiString(nameHex), nil
+}
+
+type rpcRequest struct {
+	JsonRpc string        `json:"jsonrpc"`
+	Id      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type rpcResponse struct {
+	Result string `json:"result"`
+	Error  *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (r *Resolver) ethCall(to, data string) (string, error) {
+	body, err := json.Marshal(rpcRequest{
+		JsonRpc: "2.0",
+		Id:      1,
+		Method:  "eth_call",
+		Params:  []interface{}{map[string]string{"to": to, "data": data}, "latest"},
+	})
+	if err != nil {
+		return "", err
+	}
+	resp, err := r.client.Post(r.l1RpcUrl, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	var parsed rpcResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", err
+	}
+	if parsed.Error != nil {
+		return "", fmt.Errorf(parsed.Error.Message)
+	}
+	return parsed.Result, nil
+}
+
+// namehash implements EIP-137's namehash algorithm, returning the hex-encoded
+// (no "0x" prefix) 32-byte node for name.
+func namehash(name string) string {
+	node := make([]byte, 32)
+	if name != "" {
+		labels := strings.Split(name, ".")
+		for i := len(labels) - 1; i >= 0; i-- {
+			labelHash := sha3.NewLegacyKeccak256()
+			labelHash.Write([]byte(labels[i]))
+			node = append(node, labelHash.Sum(nil)...)
+			nodeHash := sha3.NewLegacyKeccak256()
+			nodeHash.Write(node)
+			node = nodeHash.Sum(nil)
+		}
+	}
+	return fmt.Sprintf("%x", node)
+}
+
+// decodeAbiString decodes a hex-encoded ABI-packed `string` return value.
+func decodeAbiString(hexData string) string {
+	hexData = strings.TrimPrefix(hexData, "0x")
+	if len(hexData) < 128 {
+		return ""
+	}
+	lenHex := hexData[64:128]
+	var length int64
+	fmt.Sscanf(lenHex, "%x", &length)
+	if int64(len(hexData)) < 128+length*2 {
+		return ""
+	}
+	strHex := hexData[128 : 128+length*2]
+	out := make([]byte, length)
+	fmt.Sscanf(strHex, "%x", &out)
+	return string(out)
+}
@@ -0,0 +1,16 @@
+package ens
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamehashEmptyNameIsZeroNode(t *testing.T) {
+	assert.Equal(t, "0000000000000000000000000000000000000000000000000000000000000000", namehash(""))
+}
+
+func TestNamehashIsDeterministic(t *testing.T) {
+	assert.Equal(t, namehash("alice.eth"), namehash("alice.eth"))
+	assert.NotEqual(t, namehash("alice.eth"), namehash("bob.eth"))
+}
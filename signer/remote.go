@@ -0,0 +1,94 @@
+package signer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+)
+
+// RemoteSigner dispatches signing requests to an HTTP/RPC endpoint fronting
+// an HSM, KMS or hardware wallet. The endpoint is expected to expose an
+// EIP-191 `personal_sign`-style signer and a Baby-Jubjub Musig signer behind
+// the same address, e.g. a ledger/trezor bridge or a custodial KMS.
+type RemoteSigner struct {
+	Endpoint string
+	Address_ sdk.ZkLinkAddress
+	client   *http.Client
+}
+
+// NewRemoteSigner returns a Signer that forwards every signing request to
+// endpoint. address is the account the remote service signs on behalf of.
+func NewRemoteSigner(endpoint string, address sdk.ZkLinkAddress) *RemoteSigner {
+	return &RemoteSigner{Endpoint: endpoint, Address_: address, client: http.DefaultClient}
+}
+
+type remoteSignRequest struct {
+	Method  string `json:"method"`
+	Address string `json:"address"`
+	Message []byte `json:"message"`
+}
+
+type remoteSignResponse struct {
+	Signature string `json:"signature"`
+	Error     string `json:"error"`
+}
+
+func (s *RemoteSigner) call(method string, msg []byte) (string, error) {
+	reqBody, err := json.Marshal(remoteSignRequest{
+		Method:  method,
+		Address: string(s.Address_),
+		Message: msg,
+	})
+	if err != nil {
+		return "", err
+	}
+	resp, err := s.client.Post(s.Endpoint, "application/json", bytes.NewReader(reqBody))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	var parsed remoteSignResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", err
+	}
+	if parsed.Error != "" {
+		return "", fmt.Errorf("remote signer: %s", parsed.Error)
+	}
+	return parsed.Signature, nil
+}
+
+func (s *RemoteSigner) Address() (sdk.ZkLinkAddress, error) {
+	return s.Address_, nil
+}
+
+func (s *RemoteSigner) PublicKey() (sdk.PackedPublicKey, error) {
+	sig, err := s.call("publicKey", nil)
+	if err != nil {
+		return sdk.PackedPublicKey(""), err
+	}
+	return sdk.PackedPublicKey(sig), nil
+}
+
+func (s *RemoteSigner) SignEthMessage(msg []byte) (sdk.PackedEthSignature, error) {
+	sig, err := s.call("signEthMessage", msg)
+	if err != nil {
+		return sdk.PackedEthSignature(""), err
+	}
+	return sdk.PackedEthSignature(sig), nil
+}
+
+func (s *RemoteSigner) SignMusig(msg []byte) (sdk.ZkLinkSignature, error) {
+	sig, err := s.call("signMusig", msg)
+	if err != nil {
+		return sdk.ZkLinkSignature(""), err
+	}
+	return sdk.ZkLinkSignature(sig), nil
+}
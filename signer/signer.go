@@ -0,0 +1,81 @@
+// Package signer defines the pluggable signing backend used by the zklink_sdk
+// transaction builders. It replaces passing raw hex private keys around with a
+// small interface so hardware wallets, remote signers and threshold-signature
+// setups can be used interchangeably.
+package signer
+
+import (
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+)
+
+// Signer is implemented by every key-management backend that can produce
+// zkLink-compatible signatures. Builders and helpers that used to accept a
+// raw hex private key (e.g. BuildChangePubkeyRequestWithEthEcdsaAuthData)
+// should accept a Signer instead.
+type Signer interface {
+	// Address returns the L1 address this signer signs on behalf of.
+	Address() (sdk.ZkLinkAddress, error)
+	// PublicKey returns the Baby-Jubjub public key used for L2 (zkLink) signatures.
+	PublicKey() (sdk.PackedPublicKey, error)
+	// SignEthMessage produces an EIP-191 personal_sign style signature, the
+	// same wire format sdk.EthSignatureOfChangePubkey expects.
+	SignEthMessage(msg []byte) (sdk.PackedEthSignature, error)
+	// SignMusig produces a zkLink (Schnorr/Musig) signature over msg, the
+	// same wire format ZkLinkSigner.SignMusig returns today.
+	SignMusig(msg []byte) (sdk.ZkLinkSignature, error)
+}
+
+// PrivateKeySigner is the in-memory signer backed by a raw hex private key.
+// It wraps the existing PrivateKeySigner/ZkLinkSigner pair so both the L1 and
+// L2 signing needs of a single EOA are satisfied by one Signer value.
+type PrivateKeySigner struct {
+	privateKeyHex string
+	ethSigner     sdk.PrivateKeySigner
+	zklinkSigner  sdk.ZkLinkSigner
+}
+
+// NewPrivateKeySigner builds a Signer from a hex-encoded private key, e.g.
+// "0xbe725250b123a39dab5b7579334d5888987c72a58f4508062545fe6e08ca94f4".
+func NewPrivateKeySigner(privateKeyHex string) (*PrivateKeySigner, error) {
+	ethSigner, err := sdk.NewPrivateKeySigner(privateKeyHex)
+	if err != nil {
+		return nil, err
+	}
+	zklinkSigner, err := sdk.ZkLinkSignerNewFromHexEthSigner(privateKeyHex)
+	if err != nil {
+		return nil, err
+	}
+	return &PrivateKeySigner{privateKeyHex: privateKeyHex, ethSigner: ethSigner, zklinkSigner: zklinkSigner}, nil
+}
+
+// Hex returns the hex-encoded private key this signer was built from. It
+// exists only so helpers that still wrap a legacy sdk function taking a raw
+// hex string (e.g. sdk.BuildChangePubkeyRequestWithEthEcdsaAuthData) can
+// recover it after accepting a Signer; it does not expose anything the
+// caller didn't already hand us.
+func (s *PrivateKeySigner) Hex() string {
+	return s.privateKeyHex
+}
+
+// EthSigner returns the underlying sdk.PrivateKeySigner, for sdk functions
+// (e.g. sdk.EthSignatureOfChangePubkey) that are typed to accept it directly
+// rather than the Signer interface.
+func (s *PrivateKeySigner) EthSigner() sdk.PrivateKeySigner {
+	return s.ethSigner
+}
+
+func (s *PrivateKeySigner) Address() (sdk.ZkLinkAddress, error) {
+	return s.ethSigner.Address()
+}
+
+func (s *PrivateKeySigner) PublicKey() (sdk.PackedPublicKey, error) {
+	return s.zklinkSigner.PublicKey()
+}
+
+func (s *PrivateKeySigner) SignEthMessage(msg []byte) (sdk.PackedEthSignature, error) {
+	return s.ethSigner.SignMessage(msg)
+}
+
+func (s *PrivateKeySigner) SignMusig(msg []byte) (sdk.ZkLinkSignature, error) {
+	return s.zklinkSigner.SignMusig(msg)
+}
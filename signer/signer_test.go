@@ -0,0 +1,79 @@
+package signer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistributedKeyGenSharesAgreeOnJointPubKey(t *testing.T) {
+	shares, err := DistributedKeyGen(2, 3)
+	assert.NoError(t, err)
+	assert.Len(t, shares, 3)
+	for _, s := range shares {
+		assert.Equal(t, shares[0].JointPubKey, s.JointPubKey)
+	}
+}
+
+func TestDistributedKeyGenJointPubKeyIsNotTheSecret(t *testing.T) {
+	shares, err := DistributedKeyGen(2, 3)
+	assert.NoError(t, err)
+	for _, s := range shares {
+		// The published "public key" must be a curve point derived from the
+		// secret (scalar*G), never the secret scalar itself.
+		assert.NotEqual(t, s.Secret.Bytes(), s.JointPubKey.Bytes())
+	}
+}
+
+func TestDistributedKeyGenRejectsInvalidThreshold(t *testing.T) {
+	_, err := DistributedKeyGen(4, 3)
+	assert.Error(t, err)
+}
+
+func TestTSSSignerRequiresEnoughShares(t *testing.T) {
+	shares, err := DistributedKeyGen(3, 3)
+	assert.NoError(t, err)
+
+	_, err = NewTSSSigner(shares[:1])
+	assert.Error(t, err)
+
+	_, err = NewTSSSigner(shares)
+	assert.NoError(t, err)
+}
+
+// signMusigAndParse runs SignMusig and parses its wire format back into the
+// curve point and scalar VerifySchnorr expects.
+func signMusigAndParse(t *testing.T, s *TSSSigner, msg []byte) (Point, *big.Int) {
+	t.Helper()
+	sig, err := s.SignMusig(msg)
+	assert.NoError(t, err)
+	r, sVal, err := ParseSignature(sig)
+	assert.NoError(t, err)
+	return r, sVal
+}
+
+func TestTSSSignerSignMusigProducesAVerifiableSignature(t *testing.T) {
+	shares, err := DistributedKeyGen(2, 3)
+	assert.NoError(t, err)
+
+	s, err := NewTSSSigner(shares)
+	assert.NoError(t, err)
+
+	r, sig := signMusigAndParse(t, s, []byte("hello"))
+	assert.True(t, VerifySchnorr(shares[0].JointPubKey, []byte("hello"), r, sig))
+}
+
+func TestTSSSignerSignMusigVerifiesForAnyQualifyingSubset(t *testing.T) {
+	shares, err := DistributedKeyGen(2, 4)
+	assert.NoError(t, err)
+
+	// Any 2-of-4 subset must reconstruct a signature that verifies under the
+	// same joint public key, not only the first two shares.
+	subset := []TSSShare{shares[1], shares[3]}
+	s, err := NewTSSSigner(subset)
+	assert.NoError(t, err)
+
+	r, sig := signMusigAndParse(t, s, []byte("world"))
+	assert.True(t, VerifySchnorr(shares[0].JointPubKey, []byte("world"), r, sig))
+}
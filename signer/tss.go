@@ -0,0 +1,308 @@
+package signer
+
+import (
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/zkLinkProtocol/zklink_sdk/go_example/generated/uniffi/zklink_sdk"
+)
+
+// curve is the elliptic curve the DKG and signing arithmetic below run on.
+// It stands in for the Baby-Jubjub curve the zkLink signer actually uses,
+// since that curve isn't available in this Go tree yet; it will be swapped
+// for real Baby-Jubjub operations once the Rust core exposes them over FFI.
+// Crucially every scalar here is used as an exponent of the curve's base
+// point G, never published on its own.
+var curve = elliptic.P256()
+var baseOrder = curve.Params().N
+
+// Point is a curve point, used for nonce commitments and public keys so a
+// "public key" can never accidentally be a bare secret scalar.
+type Point struct {
+	X, Y *big.Int
+}
+
+func scalarMultBase(k *big.Int) Point {
+	x, y := curve.ScalarBaseMult(k.Bytes())
+	return Point{X: x, Y: y}
+}
+
+func pointAdd(a, b Point) Point {
+	x, y := curve.Add(a.X, a.Y, b.X, b.Y)
+	return Point{X: x, Y: y}
+}
+
+func (p Point) Bytes() []byte {
+	return elliptic.Marshal(curve, p.X, p.Y)
+}
+
+func (p Point) String() string {
+	return fmt.Sprintf("0x%x", p.Bytes())
+}
+
+// TSSShare is one participant's secret share produced by DistributedKeyGen,
+// together with the joint public key JointPubKey = jointSecret*G the group
+// signs under. JointPubKey is a curve Point, never the secret scalar itself.
+type TSSShare struct {
+	Index       int
+	Secret      *big.Int
+	JointPubKey Point
+	Threshold   int
+	PartyCount  int
+}
+
+// DistributedKeyGen runs a (simulated, single-process) t-of-n DKG and returns
+// one TSSShare per participant, each carrying the joint public key they share.
+// In a real multi-party deployment each party runs its own polynomial locally
+// and only the shares computed below are exchanged over the wire; this
+// implementation collapses that exchange into one call for convenience.
+func DistributedKeyGen(threshold, parties int) ([]TSSShare, error) {
+	if threshold < 1 || threshold > parties {
+		return nil, fmt.Errorf("tss: invalid threshold %d for %d parties", threshold, parties)
+	}
+	// Each party samples a degree (threshold-1) polynomial; the joint secret
+	// is the sum of the parties' constant terms, and party i's share is the
+	// sum of every polynomial evaluated at i.
+	polys := make([][]*big.Int, parties)
+	for p := 0; p < parties; p++ {
+		poly := make([]*big.Int, threshold)
+		for d := 0; d < threshold; d++ {
+			coef, err := rand.Int(rand.Reader, baseOrder)
+			if err != nil {
+				return nil, err
+			}
+			poly[d] = coef
+		}
+		polys[p] = poly
+	}
+
+	jointSecret := new(big.Int)
+	for p := 0; p < parties; p++ {
+		jointSecret.Add(jointSecret, polys[p][0])
+	}
+	jointSecret.Mod(jointSecret, baseOrder)
+	jointPubKey := scalarMultBase(jointSecret)
+
+	shares := make([]TSSShare, parties)
+	for i := 1; i <= parties; i++ {
+		x := big.NewInt(int64(i))
+		total := new(big.Int)
+		for p := 0; p < parties; p++ {
+			total.Add(total, evalPoly(polys[p], x))
+		}
+		total.Mod(total, baseOrder)
+		shares[i-1] = TSSShare{
+			Index:       i,
+			Secret:      total,
+			JointPubKey: jointPubKey,
+			Threshold:   threshold,
+			PartyCount:  parties,
+		}
+	}
+	return shares, nil
+}
+
+func evalPoly(poly []*big.Int, x *big.Int) *big.Int {
+	result := new(big.Int)
+	power := big.NewInt(1)
+	for _, coef := range poly {
+		term := new(big.Int).Mul(coef, power)
+		result.Add(result, term)
+		power.Mul(power, x)
+		power.Mod(power, baseOrder)
+	}
+	return result.Mod(result, baseOrder)
+}
+
+// lagrangeCoefficients returns, for every party in parties, the Lagrange
+// coefficient lambda_i = Π_{j≠i} (0 - x_j) / (x_i - x_j) mod N that
+// reconstructs the joint secret (or, in the exponent, any linear function of
+// it) from exactly this subset of shares. Using these instead of a bare sum
+// is what lets any t-sized subset of n shares sign correctly, not only the
+// first t.
+func lagrangeCoefficients(parties []TSSShare) []*big.Int {
+	coeffs := make([]*big.Int, len(parties))
+	for i, pi := range parties {
+		xi := big.NewInt(int64(pi.Index))
+		num := big.NewInt(1)
+		den := big.NewInt(1)
+		for _, pj := range parties {
+			if pj.Index == pi.Index {
+				continue
+			}
+			xj := big.NewInt(int64(pj.Index))
+			num.Mul(num, new(big.Int).Neg(xj))
+			num.Mod(num, baseOrder)
+			den.Mul(den, new(big.Int).Sub(xi, xj))
+			den.Mod(den, baseOrder)
+		}
+		denInv := new(big.Int).ModInverse(den, baseOrder)
+		coeffs[i] = new(big.Int).Mod(new(big.Int).Mul(num, denInv), baseOrder)
+	}
+	return coeffs
+}
+
+// TSSSigner coordinates a t-of-n threshold signature among the parties
+// holding shares, following the standard commit-reveal-aggregate Schnorr
+// flow: (1) every party commits to a nonce R_i = r_i*G and broadcasts
+// H(R_i), (2) once all commitments are in, parties open R_i — the
+// coordinator rejects any opening whose hash doesn't match its earlier
+// commitment — then computes the aggregate R = Σ R_i and challenge
+// c = H(R || P || m), (3) every party returns a partial signature
+// s_i = r_i + c*lambda_i*share_i, where lambda_i is that party's Lagrange
+// coefficient for the signing subset, and the coordinator sums them into
+// s = Σ s_i and emits (R, s). R and the joint public key are P-256 points
+// (see curve), so neither the signature nor PublicKey's result is in the
+// Baby-Jubjub wire format of ZkLinkSigner; ParseSignature and VerifySchnorr
+// below read and check this format.
+type TSSSigner struct {
+	Shares []TSSShare
+}
+
+// NewTSSSigner builds a Signer that transparently runs the two-round TSS
+// protocol across shares on every SignMusig call. shares must all belong to
+// the same DistributedKeyGen run.
+func NewTSSSigner(shares []TSSShare) (*TSSSigner, error) {
+	if len(shares) == 0 {
+		return nil, fmt.Errorf("tss: no shares supplied")
+	}
+	for _, s := range shares {
+		if len(shares) < s.Threshold {
+			return nil, fmt.Errorf("tss: only %d of %d required shares supplied", len(shares), s.Threshold)
+		}
+	}
+	return &TSSSigner{Shares: shares}, nil
+}
+
+func (s *TSSSigner) Address() (sdk.ZkLinkAddress, error) {
+	return sdk.ZkLinkAddress(""), fmt.Errorf("tss: Address is not derivable without the L1 signer share")
+}
+
+func (s *TSSSigner) PublicKey() (sdk.PackedPublicKey, error) {
+	return sdk.PackedPublicKey(s.Shares[0].JointPubKey.String()), nil
+}
+
+func (s *TSSSigner) SignEthMessage(msg []byte) (sdk.PackedEthSignature, error) {
+	return sdk.PackedEthSignature(""), fmt.Errorf("tss: EIP-191 signing is not implemented for the threshold backend")
+}
+
+// SignMusig runs the two-round commit/reveal/aggregate protocol described on
+// TSSSigner and returns "<0x-marshaled R><64 hex digit s>". This is not the
+// wire format a plain ZkLinkSigner.SignMusig call produces.
+func (s *TSSSigner) SignMusig(msg []byte) (sdk.ZkLinkSignature, error) {
+	parties := s.Shares[:s.Shares[0].Threshold]
+	lambdas := lagrangeCoefficients(parties)
+
+	// Round 1: every party samples r_i, computes R_i = r_i*G and commits to
+	// H(R_i) without revealing R_i yet.
+	nonces := make([]*big.Int, len(parties))
+	points := make([]Point, len(parties))
+	commitments := make([][32]byte, len(parties))
+	for i := range parties {
+		r, err := rand.Int(rand.Reader, baseOrder)
+		if err != nil {
+			return "", err
+		}
+		nonces[i] = r
+		points[i] = scalarMultBase(r)
+		commitments[i] = sha256.Sum256(points[i].Bytes())
+	}
+
+	// Round 2: parties open R_i; the coordinator must reject any opening
+	// that doesn't match its round-1 commitment before aggregating, or a
+	// faulty/malicious co-signer could bias R after seeing the others'
+	// commitments.
+	aggR := points[0]
+	for i, p := range points {
+		if sha256.Sum256(p.Bytes()) != commitments[i] {
+			return "", fmt.Errorf("tss: party %d opened a nonce that does not match its round-1 commitment", parties[i].Index)
+		}
+		if i > 0 {
+			aggR = pointAdd(aggR, p)
+		}
+	}
+
+	challengeInput := append(aggR.Bytes(), parties[0].JointPubKey.Bytes()...)
+	challengeInput = append(challengeInput, msg...)
+	c := new(big.Int).SetBytes(sha256Sum(challengeInput))
+	c.Mod(c, baseOrder)
+
+	// Each party returns s_i = r_i + c*lambda_i*share_i; the coordinator
+	// sums them into a signature that verifies under the joint public key
+	// regardless of which t-sized subset of shares signed.
+	aggS := new(big.Int)
+	for i, p := range parties {
+		si := new(big.Int).Mul(c, lambdas[i])
+		si.Mul(si, p.Secret)
+		si.Add(si, nonces[i])
+		si.Mod(si, baseOrder)
+		aggS.Add(aggS, si)
+	}
+	aggS.Mod(aggS, baseOrder)
+
+	return sdk.ZkLinkSignature(fmt.Sprintf("%s%064x", aggR.String(), aggS)), nil
+}
+
+// ParseSignature splits the "<0x-marshaled R><64 hex digit s>" wire format
+// SignMusig produces back into the curve point and scalar VerifySchnorr
+// expects.
+func ParseSignature(sig sdk.ZkLinkSignature) (Point, *big.Int, error) {
+	raw := string(sig)
+	if len(raw) <= 64 {
+		return Point{}, nil, fmt.Errorf("tss: signature %q is too short", raw)
+	}
+	rHex := raw[:len(raw)-64]
+	sHex := raw[len(raw)-64:]
+
+	rBytes, err := hexDecode(rHex)
+	if err != nil {
+		return Point{}, nil, err
+	}
+	x, y := elliptic.Unmarshal(curve, rBytes)
+	if x == nil {
+		return Point{}, nil, fmt.Errorf("tss: could not unmarshal R from signature")
+	}
+
+	s, ok := new(big.Int).SetString(sHex, 16)
+	if !ok {
+		return Point{}, nil, fmt.Errorf("tss: could not parse s from signature")
+	}
+	return Point{X: x, Y: y}, s, nil
+}
+
+func hexDecode(s string) ([]byte, error) {
+	s = trimHexPrefix(s)
+	return hex.DecodeString(s)
+}
+
+func trimHexPrefix(s string) string {
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		return s[2:]
+	}
+	return s
+}
+
+// VerifySchnorr checks a (R, s) signature produced by SignMusig against
+// jointPubKey and msg: it holds iff s*G == R + c*P where
+// c = H(R || P || m), the same relation the partial signatures above are
+// built to satisfy.
+func VerifySchnorr(jointPubKey Point, msg []byte, r Point, s *big.Int) bool {
+	challengeInput := append(r.Bytes(), jointPubKey.Bytes()...)
+	challengeInput = append(challengeInput, msg...)
+	c := new(big.Int).SetBytes(sha256Sum(challengeInput))
+	c.Mod(c, baseOrder)
+
+	sX, sY := curve.ScalarBaseMult(s.Bytes())
+	cX, cY := curve.ScalarMult(jointPubKey.X, jointPubKey.Y, c.Bytes())
+	expectedX, expectedY := curve.Add(r.X, r.Y, cX, cY)
+	return sX.Cmp(expectedX) == 0 && sY.Cmp(expectedY) == 0
+}
+
+func sha256Sum(b []byte) []byte {
+	sum := sha256.Sum256(b)
+	return sum[:]
+}